@@ -0,0 +1,181 @@
+package telebot
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// Update represents an incoming update from Telegram.
+type Update struct {
+	ID      int      `json:"update_id"`
+	Message *Message `json:"message,omitempty"`
+}
+
+// Bot represents a Telegram bot instance, holding the handlers registered
+// against it and the HTTP client used to talk to the Bot API.
+type Bot struct {
+	Token string
+	URL   string
+
+	client *http.Client
+
+	handlers      map[string]HandlerFunc
+	topicHandlers map[topicHandlerKey]map[string]HandlerFunc
+}
+
+// Handle registers a handler for endpoint, wrapping it with any
+// middleware given, outermost first.
+func (b *Bot) Handle(endpoint interface{}, h HandlerFunc, m ...MiddlewareFunc) {
+	end := extractEndpoint(endpoint)
+	if end == "" {
+		panic("telebot: unsupported endpoint")
+	}
+
+	handler := h
+	for i := len(m) - 1; i >= 0; i-- {
+		handler = m[i](handler)
+	}
+
+	if b.handlers == nil {
+		b.handlers = make(map[string]HandlerFunc)
+	}
+	b.handlers[end] = handler
+}
+
+// handle dispatches end to its Handle-registered handler, if any, and
+// reports whether one was found.
+func (b *Bot) handle(end string, c Context) bool {
+	h, ok := b.handlers[end]
+	if !ok {
+		return false
+	}
+	b.runHandler(h, c)
+	return true
+}
+
+// runHandler invokes h with c. Any error h returns is discarded.
+func (b *Bot) runHandler(h HandlerFunc, c Context) {
+	_ = h(c)
+}
+
+// ProcessUpdate routes a single incoming Update to the matching
+// HandleTopic or Handle-registered handler.
+func (b *Bot) ProcessUpdate(u Update) {
+	c := &nativeContext{b: b, u: u}
+
+	m := u.Message
+	if m == nil {
+		return
+	}
+
+	switch {
+	case m.PinnedMessage != nil:
+		b.handleTopic(OnPinned, c)
+		return
+	case m.MigrateTo != 0:
+		b.handleTopic(OnMigration, c)
+		return
+	}
+
+	if b.handleForumTopicService(c) {
+		return
+	}
+
+	b.handleTopic(OnText, c)
+}
+
+// Raw executes method against the Bot API with params, returning the raw
+// JSON response body.
+func (b *Bot) Raw(method string, params map[string]string) ([]byte, error) {
+	values := make(url.Values, len(params))
+	for k, v := range params {
+		values.Set(k, v)
+	}
+
+	endpoint := fmt.Sprintf("%s/bot%s/%s", b.URL, b.Token, method)
+	resp, err := b.client.PostForm(endpoint, values)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	var result struct {
+		OK          bool   `json:"ok"`
+		Description string `json:"description"`
+		ErrorCode   int    `json:"error_code"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, wrapError(err)
+	}
+	if !result.OK {
+		return nil, &Error{Code: result.ErrorCode, Description: result.Description}
+	}
+
+	return data, nil
+}
+
+// Send sends what to recipient, merging any trailing *SendOptions into
+// the outgoing request.
+func (b *Bot) Send(to Recipient, what interface{}, opts ...interface{}) (*Message, error) {
+	sendOpts := extractOptions(opts)
+
+	if topic, ok := to.(*Topic); ok {
+		if sendOpts.ThreadID == 0 {
+			cp := *sendOpts
+			cp.ThreadID = topic.ThreadID
+			sendOpts = &cp
+		}
+		to = topic.Chat
+	}
+
+	switch v := what.(type) {
+	case string:
+		return b.sendText(to, v, sendOpts)
+	case *Photo:
+		return b.sendPhoto(to, v, sendOpts)
+	case *Video:
+		return b.sendVideo(to, v, sendOpts)
+	case *Animation:
+		return b.sendAnimation(to, v, sendOpts)
+	case []InputMedia:
+		msgs, err := b.sendMediaGroup(to, v, sendOpts)
+		if err != nil {
+			return nil, err
+		}
+		if len(msgs) == 0 {
+			return nil, nil
+		}
+		return &msgs[0], nil
+	default:
+		return nil, fmt.Errorf("telebot: unsupported sendable type %T", what)
+	}
+}
+
+func (b *Bot) sendText(to Recipient, text string, opts *SendOptions) (*Message, error) {
+	params := map[string]string{
+		"chat_id": to.Recipient(),
+		"text":    text,
+	}
+	embedSendOptions(params, opts)
+
+	data, err := b.Raw("sendMessage", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Result *Message
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, wrapError(err)
+	}
+	return resp.Result, nil
+}
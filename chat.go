@@ -0,0 +1,63 @@
+package telebot
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// ChatType represents one of the kinds of chat Telegram distinguishes.
+type ChatType string
+
+const (
+	ChatPrivate    ChatType = "private"
+	ChatGroup      ChatType = "group"
+	ChatSupergroup ChatType = "supergroup"
+	ChatChannel    ChatType = "channel"
+)
+
+// Chat represents a Telegram chat: private, group, supergroup or channel.
+type Chat struct {
+	ID    int64    `json:"id"`
+	Type  ChatType `json:"type"`
+	Title string   `json:"title,omitempty"`
+
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+	Username  string `json:"username,omitempty"`
+
+	// IsForum reports whether the supergroup has topics (forum) enabled.
+	IsForum bool `json:"is_forum,omitempty"`
+
+	// ActiveUsernames lists all of the chat's active usernames.
+	ActiveUsernames []string `json:"active_usernames,omitempty"`
+
+	// EmojiStatusCustomEmojiID is the custom emoji identifier of the
+	// chat's emoji status, if any.
+	EmojiStatusCustomEmojiID string `json:"emoji_status_custom_emoji_id,omitempty"`
+}
+
+// Recipient returns chat ID, implementing the Recipient interface.
+func (c *Chat) Recipient() string {
+	return strconv.FormatInt(c.ID, 10)
+}
+
+// ChatByID fetches the up-to-date chat object for id, including
+// forum-related fields such as IsForum.
+func (b *Bot) ChatByID(id int64) (*Chat, error) {
+	params := map[string]string{
+		"chat_id": strconv.FormatInt(id, 10),
+	}
+
+	data, err := b.Raw("getChat", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Result *Chat
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, wrapError(err)
+	}
+	return resp.Result, nil
+}
@@ -0,0 +1,58 @@
+package telebot
+
+// Context wraps a single incoming Update and provides the handler-facing
+// API for inspecting it and replying to it.
+type Context interface {
+	// Bot returns the bot instance that created this context.
+	Bot() *Bot
+
+	// Update returns the update this context wraps.
+	Update() Update
+
+	// Message returns the incoming message, if any.
+	Message() *Message
+
+	// ThreadID returns the message thread (forum topic) ID the update
+	// originated from, or 0 if it isn't part of a topic.
+	ThreadID() int
+
+	// Send sends what to the chat this context is attached to, defaulting
+	// to the originating thread unless opts overrides it.
+	Send(what interface{}, opts ...interface{}) error
+
+	// Reply replies to the incoming message, defaulting to the
+	// originating thread unless opts overrides it.
+	Reply(what interface{}, opts ...interface{}) error
+}
+
+// nativeContext is the default Context implementation, wrapping a single
+// incoming Update.
+type nativeContext struct {
+	b *Bot
+	u Update
+}
+
+func (c *nativeContext) Bot() *Bot {
+	return c.b
+}
+
+func (c *nativeContext) Update() Update {
+	return c.u
+}
+
+func (c *nativeContext) Message() *Message {
+	return c.u.Message
+}
+
+func (c *nativeContext) Send(what interface{}, opts ...interface{}) error {
+	sendOpts := c.withThreadDefault(extractOptions(opts))
+	_, err := c.b.Send(c.Message().Chat, what, sendOpts)
+	return err
+}
+
+func (c *nativeContext) Reply(what interface{}, opts ...interface{}) error {
+	sendOpts := c.withThreadDefault(extractOptions(opts))
+	sendOpts.ReplyTo = c.Message()
+	_, err := c.b.Send(c.Message().Chat, what, sendOpts)
+	return err
+}
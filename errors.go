@@ -0,0 +1,20 @@
+package telebot
+
+import "fmt"
+
+// Error represents a Telegram API error, as returned by a non-ok
+// response to any Bot.Raw call.
+type Error struct {
+	Code        int
+	Description string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("telebot: %s (%d)", e.Description, e.Code)
+}
+
+// wrapError wraps err into a telebot-prefixed error, so callers don't
+// need to care whether it came from the HTTP layer or JSON decoding.
+func wrapError(err error) error {
+	return fmt.Errorf("telebot: %w", err)
+}
@@ -0,0 +1,38 @@
+package telebot
+
+// Endpoints for forum topic service messages, following the same pattern
+// as OnPinned and OnMigration: each fires when the corresponding field is
+// populated on an incoming Message.
+const (
+	OnForumTopicCreated         = "\aforum_topic_created"
+	OnForumTopicEdited          = "\aforum_topic_edited"
+	OnForumTopicClosed          = "\aforum_topic_closed"
+	OnForumTopicReopened        = "\aforum_topic_reopened"
+	OnGeneralForumTopicHidden   = "\ageneral_forum_topic_hidden"
+	OnGeneralForumTopicUnhidden = "\ageneral_forum_topic_unhidden"
+)
+
+// handleForumTopicService routes a forum topic service message on c's
+// Message to its endpoint, the same way pinned messages and chat
+// migrations are surfaced via ProcessUpdate. It returns false if the
+// message carries none of these service payloads.
+func (b *Bot) handleForumTopicService(c Context) bool {
+	m := c.Message()
+	switch {
+	case m.ForumTopicCreated != nil:
+		b.handleTopic(OnForumTopicCreated, c)
+	case m.ForumTopicEdited != nil:
+		b.handleTopic(OnForumTopicEdited, c)
+	case m.ForumTopicClosed != nil:
+		b.handleTopic(OnForumTopicClosed, c)
+	case m.ForumTopicReopened != nil:
+		b.handleTopic(OnForumTopicReopened, c)
+	case m.GeneralForumTopicHidden != nil:
+		b.handleTopic(OnGeneralForumTopicHidden, c)
+	case m.GeneralForumTopicUnhidden != nil:
+		b.handleTopic(OnGeneralForumTopicUnhidden, c)
+	default:
+		return false
+	}
+	return true
+}
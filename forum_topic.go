@@ -8,6 +8,11 @@ import (
 
 type MessageThreadID int
 
+// ErrNotAForum is returned by forum-management calls in this file when
+// chat.IsForum is false, so callers get an actionable error instead of a
+// Telegram-side 400.
+var ErrNotAForum = errors.New("telebot: chat is not a forum")
+
 // ForumTopic represents a forum topic.
 type ForumTopic struct {
 	Name            string `json:"name"`
@@ -22,6 +27,11 @@ type ForumTopic struct {
 
 	// (Optional) Unique identifier of the custom emoji shown as the topic icon.
 	IconCustomEmojiID string `json:"icon_custom_emoji_id,omitempty"`
+
+	// ClearIcon, when set on EditForumTopic, requests the topic's custom
+	// emoji icon be removed in favor of the fallback topic icon. It has
+	// no effect on CreateForumTopic.
+	ClearIcon bool `json:"-"`
 }
 
 // ForumTopicCreated represents a service message about a new
@@ -70,6 +80,9 @@ type GeneralForumTopicUnhidden struct{}
 // the CanManageTopics administrator rights. Returns information about the created
 // topic as a *ForumTopic object.
 func (b *Bot) CreateForumTopic(chat *Chat, ft *ForumTopic) (*ForumTopic, error) {
+	if !chat.IsForum {
+		return nil, ErrNotAForum
+	}
 	if ft == nil {
 		return nil, errors.New("telebot: forum topic is nil")
 	}
@@ -104,20 +117,30 @@ func (b *Bot) CreateForumTopic(chat *Chat, ft *ForumTopic) (*ForumTopic, error)
 // The bot must be an administrator in the chat for this to work and must have
 // CanManageTopics administrator rights, unless it is the creator of the topic.
 //
-// The parameters name and icon are optional. If they are omitted, the existing
-// values are kept.
-func (b *Bot) EditForumTopic(chat *Chat, msgThreadID int, name string, icon string) error {
+// ft.Name and ft.IconCustomEmojiID are optional; if left empty, the existing
+// values are kept. Set ft.ClearIcon to switch the topic to the fallback icon,
+// since an empty IconCustomEmojiID alone can't be told apart from "keep it".
+func (b *Bot) EditForumTopic(chat *Chat, ft *ForumTopic) error {
+	if !chat.IsForum {
+		return ErrNotAForum
+	}
+	if ft == nil {
+		return errors.New("telebot: forum topic is nil")
+	}
 	params := map[string]string{
 		"chat_id":           chat.Recipient(),
-		"message_thread_id": strconv.Itoa(msgThreadID),
+		"message_thread_id": strconv.Itoa(ft.MessageThreadID),
 	}
 
-	if name != "" {
-		params["name"] = name
+	if ft.Name != "" {
+		params["name"] = ft.Name
 	}
 
-	if icon != "" {
-		params["icon_custom_emoji_id"] = icon
+	switch {
+	case ft.ClearIcon:
+		params["icon_custom_emoji_id"] = ""
+	case ft.IconCustomEmojiID != "":
+		params["icon_custom_emoji_id"] = ft.IconCustomEmojiID
 	}
 
 	_, err := b.Raw("editForumTopic", params)
@@ -128,6 +151,9 @@ func (b *Bot) EditForumTopic(chat *Chat, msgThreadID int, name string, icon stri
 // The bot must be an administrator in the chat for this to work and must have
 // CanManageTopics administrator rights, unless it is the creator of the topic.
 func (b *Bot) CloseForumTopic(chat *Chat, msgThreadID int) error {
+	if !chat.IsForum {
+		return ErrNotAForum
+	}
 	params := map[string]string{
 		"chat_id":           chat.Recipient(),
 		"message_thread_id": strconv.Itoa(msgThreadID),
@@ -141,6 +167,9 @@ func (b *Bot) CloseForumTopic(chat *Chat, msgThreadID int) error {
 // The bot must be an administrator in the chat for this to work and must have
 // CanManageTopics administrator rights, unless it is the creator of the topic.
 func (b *Bot) ReopenForumTopic(chat *Chat, msgThreadID int) error {
+	if !chat.IsForum {
+		return ErrNotAForum
+	}
 	params := map[string]string{
 		"chat_id":           chat.Recipient(),
 		"message_thread_id": strconv.Itoa(msgThreadID),
@@ -155,6 +184,9 @@ func (b *Bot) ReopenForumTopic(chat *Chat, msgThreadID int) error {
 // this to work and must have CanManageTopics administrator rights, unless
 // it is the creator of the topic.
 func (b *Bot) DeleteForumTopic(chat *Chat, msgThreadID int) error {
+	if !chat.IsForum {
+		return ErrNotAForum
+	}
 	params := map[string]string{
 		"chat_id":           chat.Recipient(),
 		"message_thread_id": strconv.Itoa(msgThreadID),
@@ -168,6 +200,9 @@ func (b *Bot) DeleteForumTopic(chat *Chat, msgThreadID int) error {
 // The bot must be an administrator in the chat for this to work and must have the
 // CanPinMessages administrator right in the supergroup.
 func (b *Bot) UnpinAllForumTopicMessages(chat *Chat, msgThreadID int) error {
+	if !chat.IsForum {
+		return ErrNotAForum
+	}
 	params := map[string]string{
 		"chat_id":           chat.Recipient(),
 		"message_thread_id": strconv.Itoa(msgThreadID),
@@ -198,6 +233,9 @@ func (b *Bot) GetForumTopicIconStickers() ([]Sticker, error) {
 // supergroup chat. The bot must be an administrator in the chat for this
 // to work and must have CanManageTopics administrator rights.
 func (b *Bot) EditGeneralForumTopic(chat *Chat, name string) error {
+	if !chat.IsForum {
+		return ErrNotAForum
+	}
 	params := map[string]string{
 		"chat_id": chat.Recipient(),
 		"name":    name,
@@ -211,6 +249,9 @@ func (b *Bot) EditGeneralForumTopic(chat *Chat, name string) error {
 // The bot must be an administrator in the chat for this to work and must have the
 // CanManageTopics administrator rights.
 func (b *Bot) CloseGeneralForumTopic(chat *Chat) error {
+	if !chat.IsForum {
+		return ErrNotAForum
+	}
 	params := map[string]string{
 		"chat_id": chat.Recipient(),
 	}
@@ -224,6 +265,9 @@ func (b *Bot) CloseGeneralForumTopic(chat *Chat) error {
 // CanManageTopics administrator rights. The topic will be automatically unhidden
 // if it was hidden.
 func (b *Bot) ReopenGeneralForumTopic(chat *Chat) error {
+	if !chat.IsForum {
+		return ErrNotAForum
+	}
 	params := map[string]string{
 		"chat_id": chat.Recipient(),
 	}
@@ -237,6 +281,9 @@ func (b *Bot) ReopenGeneralForumTopic(chat *Chat) error {
 // CanManageTopics administrator rights. The topic will be automatically closed
 // if it was open.
 func (b *Bot) HideGeneralForumTopic(chat *Chat) error {
+	if !chat.IsForum {
+		return ErrNotAForum
+	}
 	params := map[string]string{
 		"chat_id": chat.Recipient(),
 	}
@@ -249,6 +296,9 @@ func (b *Bot) HideGeneralForumTopic(chat *Chat) error {
 // The bot must be an administrator in the chat for this to work and must have the
 // CanManageTopics administrator rights.
 func (b *Bot) UnhideGeneralForumTopic(chat *Chat) error {
+	if !chat.IsForum {
+		return ErrNotAForum
+	}
 	params := map[string]string{
 		"chat_id": chat.Recipient(),
 	}
@@ -0,0 +1,60 @@
+package telebot
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEditForumTopicClearIcon(t *testing.T) {
+	tests := []struct {
+		name string
+		ft   *ForumTopic
+		want string
+	}{
+		{"keeps icon by default", &ForumTopic{MessageThreadID: 1}, ""},
+		{"sets icon", &ForumTopic{MessageThreadID: 1, IconCustomEmojiID: "abc"}, "abc"},
+		{"clears icon", &ForumTopic{MessageThreadID: 1, IconCustomEmojiID: "abc", ClearIcon: true}, ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var gotIcon string
+			var hasIcon bool
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.NoError(t, r.ParseForm())
+				_, hasIcon = r.Form["icon_custom_emoji_id"]
+				gotIcon = r.FormValue("icon_custom_emoji_id")
+				w.Write([]byte(`{"ok":true,"result":true}`))
+			}))
+			defer srv.Close()
+
+			b := &Bot{Token: "TEST", URL: srv.URL, client: http.DefaultClient}
+			chat := &Chat{ID: 1, IsForum: true}
+
+			err := b.EditForumTopic(chat, tt.ft)
+			require.NoError(t, err)
+
+			if tt.want == "" && !tt.ft.ClearIcon {
+				assert.False(t, hasIcon, "icon_custom_emoji_id should be omitted")
+				return
+			}
+
+			require.True(t, hasIcon, "icon_custom_emoji_id should be present")
+			assert.Equal(t, tt.want, gotIcon)
+		})
+	}
+}
+
+func TestEditForumTopicNotAForum(t *testing.T) {
+	b := &Bot{}
+	chat := &Chat{ID: 1, IsForum: false}
+
+	err := b.EditForumTopic(chat, &ForumTopic{MessageThreadID: 1})
+	assert.Equal(t, ErrNotAForum, err)
+}
@@ -0,0 +1,153 @@
+package telebot
+
+import "encoding/json"
+
+// File represents a file ready to be sent to, or already sent by,
+// Telegram.
+type File struct {
+	FileID string `json:"file_id,omitempty"`
+}
+
+// Photo represents a photo, ready to be sent or already sent.
+type Photo struct {
+	File
+
+	// HasSpoiler marks the photo as a media spoiler in the chat.
+	HasSpoiler bool `json:"has_spoiler,omitempty"`
+}
+
+// Video represents a video, ready to be sent or already sent.
+type Video struct {
+	File
+
+	// HasSpoiler marks the video as a media spoiler in the chat.
+	HasSpoiler bool `json:"has_spoiler,omitempty"`
+}
+
+// Animation represents an animation, ready to be sent or already sent.
+type Animation struct {
+	File
+
+	// HasSpoiler marks the animation as a media spoiler in the chat.
+	HasSpoiler bool `json:"has_spoiler,omitempty"`
+}
+
+// sendPhoto sends p to recipient, marking it a spoiler if either p or
+// opts requests it.
+func (b *Bot) sendPhoto(to Recipient, p *Photo, opts *SendOptions) (*Message, error) {
+	params := map[string]string{
+		"chat_id": to.Recipient(),
+		"photo":   p.FileID,
+	}
+	embedSendOptions(params, opts)
+	if p.HasSpoiler {
+		params["has_spoiler"] = "true"
+	}
+
+	return b.sendMedia("sendPhoto", params)
+}
+
+// sendVideo sends v to recipient, marking it a spoiler if either v or
+// opts requests it.
+func (b *Bot) sendVideo(to Recipient, v *Video, opts *SendOptions) (*Message, error) {
+	params := map[string]string{
+		"chat_id": to.Recipient(),
+		"video":   v.FileID,
+	}
+	embedSendOptions(params, opts)
+	if v.HasSpoiler {
+		params["has_spoiler"] = "true"
+	}
+
+	return b.sendMedia("sendVideo", params)
+}
+
+// sendAnimation sends a to recipient, marking it a spoiler if either a or
+// opts requests it.
+func (b *Bot) sendAnimation(to Recipient, a *Animation, opts *SendOptions) (*Message, error) {
+	params := map[string]string{
+		"chat_id":   to.Recipient(),
+		"animation": a.FileID,
+	}
+	embedSendOptions(params, opts)
+	if a.HasSpoiler {
+		params["has_spoiler"] = "true"
+	}
+
+	return b.sendMedia("sendAnimation", params)
+}
+
+func (b *Bot) sendMedia(method string, params map[string]string) (*Message, error) {
+	data, err := b.Raw(method, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Result *Message
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, wrapError(err)
+	}
+	return resp.Result, nil
+}
+
+// InputMedia is implemented by every album entry accepted by
+// sendMediaGroup.
+type InputMedia interface {
+	mediaType() string
+}
+
+// InputMediaPhoto represents a photo entry in a sendMediaGroup album.
+type InputMediaPhoto struct {
+	Type  string `json:"type"`
+	Media string `json:"media"`
+	inputMediaSpoiler
+}
+
+// InputMediaVideo represents a video entry in a sendMediaGroup album.
+type InputMediaVideo struct {
+	Type  string `json:"type"`
+	Media string `json:"media"`
+	inputMediaSpoiler
+}
+
+// InputMediaAnimation represents an animation entry in a sendMediaGroup
+// album.
+type InputMediaAnimation struct {
+	Type  string `json:"type"`
+	Media string `json:"media"`
+	inputMediaSpoiler
+}
+
+func (InputMediaPhoto) mediaType() string     { return "photo" }
+func (InputMediaVideo) mediaType() string     { return "video" }
+func (InputMediaAnimation) mediaType() string { return "animation" }
+
+// sendMediaGroup sends an album of media to recipient, each entry
+// carrying its own has_spoiler flag.
+func (b *Bot) sendMediaGroup(to Recipient, media []InputMedia, opts *SendOptions) ([]Message, error) {
+	params := map[string]string{
+		"chat_id": to.Recipient(),
+	}
+	embedSendOptions(params, opts)
+
+	payload, err := json.Marshal(media)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	params["media"] = string(payload)
+
+	data, err := b.Raw("sendMediaGroup", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Result []Message
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, wrapError(err)
+	}
+	return resp.Result, nil
+}
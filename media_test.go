@@ -0,0 +1,96 @@
+package telebot
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendSpoilerReachesTheWire(t *testing.T) {
+	chat := &Chat{ID: 1}
+
+	tests := []struct {
+		name string
+		send func(b *Bot) (*Message, error)
+		want string // has_spoiler form value expected, "" for absent
+	}{
+		{
+			name: "photo",
+			send: func(b *Bot) (*Message, error) {
+				return b.Send(chat, &Photo{File: File{FileID: "f1"}, HasSpoiler: true})
+			},
+			want: "true",
+		},
+		{
+			name: "video via SendOptions",
+			send: func(b *Bot) (*Message, error) {
+				return b.Send(chat, &Video{File: File{FileID: "f2"}}, &SendOptions{HasSpoiler: true})
+			},
+			want: "true",
+		},
+		{
+			name: "animation without spoiler",
+			send: func(b *Bot) (*Message, error) {
+				return b.Send(chat, &Animation{File: File{FileID: "f3"}})
+			},
+			want: "",
+		},
+		{
+			name: "album entry",
+			send: func(b *Bot) (*Message, error) {
+				return b.Send(chat, []InputMedia{
+					InputMediaPhoto{Type: "photo", Media: "f4", inputMediaSpoiler: inputMediaSpoiler{HasSpoiler: true}},
+				})
+			},
+			want: "true",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var gotIcon string
+			var hasIcon bool
+			var gotMedia string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.NoError(t, r.ParseForm())
+				_, hasIcon = r.Form["has_spoiler"]
+				gotIcon = r.FormValue("has_spoiler")
+				gotMedia = r.FormValue("media")
+
+				if strings.HasSuffix(r.URL.Path, "sendMediaGroup") {
+					w.Write([]byte(`{"ok":true,"result":[{"message_id":1,"chat":{"id":1}}]}`))
+					return
+				}
+				w.Write([]byte(`{"ok":true,"result":{"message_id":1,"chat":{"id":1}}}`))
+			}))
+			defer srv.Close()
+
+			b := &Bot{Token: "TEST", URL: srv.URL, client: http.DefaultClient}
+
+			_, err := tt.send(b)
+			require.NoError(t, err)
+
+			if tt.want == "" {
+				if tt.name == "album entry" {
+					return
+				}
+				assert.False(t, hasIcon, "has_spoiler should be omitted")
+				return
+			}
+
+			if tt.name == "album entry" {
+				assert.Contains(t, gotMedia, `"has_spoiler":true`)
+				return
+			}
+
+			require.True(t, hasIcon, "has_spoiler should be present")
+			assert.Equal(t, tt.want, gotIcon)
+		})
+	}
+}
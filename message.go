@@ -0,0 +1,29 @@
+package telebot
+
+// Message represents a Telegram message.
+type Message struct {
+	ID     int    `json:"message_id"`
+	Sender *User  `json:"from,omitempty"`
+	Chat   *Chat  `json:"chat"`
+	Text   string `json:"text,omitempty"`
+
+	// ThreadID is the identifier of the message thread (forum topic)
+	// this message belongs to, if any.
+	ThreadID int `json:"message_thread_id,omitempty"`
+
+	// HasMediaSpoiler reports whether the message media is covered by a
+	// spoiler animation.
+	HasMediaSpoiler bool `json:"has_media_spoiler,omitempty"`
+
+	PinnedMessage *Message `json:"pinned_message,omitempty"`
+
+	MigrateTo   int64 `json:"migrate_to_chat_id,omitempty"`
+	MigrateFrom int64 `json:"migrate_from_chat_id,omitempty"`
+
+	ForumTopicCreated         *ForumTopicCreated         `json:"forum_topic_created,omitempty"`
+	ForumTopicEdited          *ForumTopicEdited          `json:"forum_topic_edited,omitempty"`
+	ForumTopicClosed          *ForumTopicClosed          `json:"forum_topic_closed,omitempty"`
+	ForumTopicReopened        *ForumTopicReopened        `json:"forum_topic_reopened,omitempty"`
+	GeneralForumTopicHidden   *GeneralForumTopicHidden   `json:"general_forum_topic_hidden,omitempty"`
+	GeneralForumTopicUnhidden *GeneralForumTopicUnhidden `json:"general_forum_topic_unhidden,omitempty"`
+}
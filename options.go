@@ -0,0 +1,46 @@
+package telebot
+
+import "strconv"
+
+// SendOptions represents the set of options that can be merged into an
+// outgoing request to the Bot API, on top of whatever the Sendable
+// itself contributes.
+type SendOptions struct {
+	// ReplyTo holds a message to reply to. Reply sets this automatically.
+	ReplyTo *Message
+
+	// ThreadID directs the send at a specific forum topic within the
+	// target chat. Sending to a *Topic recipient sets this too.
+	ThreadID int
+
+	// HasSpoiler marks a photo, video or animation as a media spoiler.
+	// A Photo/Video/Animation's own HasSpoiler field takes precedence
+	// when both are set.
+	HasSpoiler bool
+}
+
+// extractOptions resolves the trailing opts of a Send/Reply call into a
+// single SendOptions, the only shape Bot.Send deals with from here on.
+func extractOptions(how []interface{}) *SendOptions {
+	opts := &SendOptions{}
+	for _, item := range how {
+		if v, ok := item.(*SendOptions); ok {
+			opts = v
+		}
+	}
+	return opts
+}
+
+// embedSendOptions threads opts into the outgoing request params, the
+// same map every send path (sendText, sendPhoto, sendMediaGroup, ...)
+// builds before calling Bot.Raw.
+func embedSendOptions(params map[string]string, opts *SendOptions) {
+	if opts == nil {
+		return
+	}
+	if opts.ReplyTo != nil {
+		params["reply_to_message_id"] = strconv.Itoa(opts.ReplyTo.ID)
+	}
+	embedThreadID(params, opts)
+	embedHasSpoiler(params, opts)
+}
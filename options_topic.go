@@ -0,0 +1,30 @@
+package telebot
+
+import "strconv"
+
+// embedThreadID copies opts.ThreadID into params as message_thread_id,
+// the same way embedSendOptions threads the rest of SendOptions into
+// outgoing request parameters. Every send path that calls
+// embedSendOptions (currently sendText, sendPhoto, sendVideo,
+// sendAnimation and sendMediaGroup) picks this up for free.
+func embedThreadID(params map[string]string, opts *SendOptions) {
+	if opts != nil && opts.ThreadID != 0 {
+		params["message_thread_id"] = strconv.Itoa(opts.ThreadID)
+	}
+}
+
+// withThreadDefault returns a SendOptions with ThreadID defaulted to the
+// thread the context's update came from, unless the caller already set
+// one. Reply and Send call this so replies stay in the originating
+// topic. It never mutates the opts the caller passed in, since that
+// pointer may be reused across calls against different contexts.
+func (c *nativeContext) withThreadDefault(opts *SendOptions) *SendOptions {
+	var cp SendOptions
+	if opts != nil {
+		cp = *opts
+	}
+	if cp.ThreadID == 0 {
+		cp.ThreadID = c.ThreadID()
+	}
+	return &cp
+}
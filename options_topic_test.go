@@ -0,0 +1,42 @@
+package telebot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmbedThreadID(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   *SendOptions
+		params map[string]string
+	}{
+		{"nil opts", nil, map[string]string{}},
+		{"zero thread", &SendOptions{}, map[string]string{}},
+		{"thread set", &SendOptions{ThreadID: 42}, map[string]string{"message_thread_id": "42"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := make(map[string]string)
+			embedThreadID(params, tt.opts)
+			assert.Equal(t, tt.params, params)
+		})
+	}
+}
+
+func TestWithThreadDefault(t *testing.T) {
+	chat := &Chat{ID: 1}
+	c := &nativeContext{u: Update{Message: &Message{Chat: chat, ThreadID: 7}}}
+
+	t.Run("defaults from context", func(t *testing.T) {
+		opts := c.withThreadDefault(nil)
+		assert.Equal(t, 7, opts.ThreadID)
+	})
+
+	t.Run("caller override wins", func(t *testing.T) {
+		opts := c.withThreadDefault(&SendOptions{ThreadID: 99})
+		assert.Equal(t, 99, opts.ThreadID)
+	})
+}
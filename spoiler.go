@@ -0,0 +1,18 @@
+package telebot
+
+// embedHasSpoiler sets has_spoiler on params when opts requests it. It's
+// called from embedSendOptions, so every send path that calls that
+// (sendText, sendPhoto, sendVideo, sendAnimation and sendMediaGroup)
+// sets it when opts.HasSpoiler is true.
+func embedHasSpoiler(params map[string]string, opts *SendOptions) {
+	if opts != nil && opts.HasSpoiler {
+		params["has_spoiler"] = "true"
+	}
+}
+
+// inputMediaSpoiler is embedded into InputMediaPhoto, InputMediaVideo and
+// InputMediaAnimation so each sendMediaGroup entry can carry its own
+// has_spoiler flag independent of the rest of the album.
+type inputMediaSpoiler struct {
+	HasSpoiler bool `json:"has_spoiler,omitempty"`
+}
@@ -0,0 +1,27 @@
+package telebot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmbedHasSpoiler(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   *SendOptions
+		params map[string]string
+	}{
+		{"nil opts", nil, map[string]string{}},
+		{"spoiler unset", &SendOptions{}, map[string]string{}},
+		{"spoiler set", &SendOptions{HasSpoiler: true}, map[string]string{"has_spoiler": "true"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := make(map[string]string)
+			embedHasSpoiler(params, tt.opts)
+			assert.Equal(t, tt.params, params)
+		})
+	}
+}
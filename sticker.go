@@ -0,0 +1,6 @@
+package telebot
+
+// Sticker represents a Telegram sticker.
+type Sticker struct {
+	FileID string `json:"file_id"`
+}
@@ -0,0 +1,34 @@
+package telebot
+
+// HandlerFunc represents a handler function, which is called when a
+// handler registered via Handle or HandleTopic is triggered.
+type HandlerFunc func(Context) error
+
+// MiddlewareFunc represents a middleware processing function, wrapping
+// a HandlerFunc with extra behavior.
+type MiddlewareFunc func(HandlerFunc) HandlerFunc
+
+// Recipient is any entity that can be the recipient of a message, e.g.
+// a Chat, a User, or a Topic.
+type Recipient interface {
+	Recipient() string
+}
+
+// Endpoints for the base update kinds telebot routes to Handle or
+// HandleTopic-registered handlers.
+const (
+	OnText      = "\atext"
+	OnPinned    = "\apinned"
+	OnMigration = "\amigration"
+)
+
+// extractEndpoint resolves endpoint into the string key it's registered
+// under, the key Handle and HandleTopic both key their handlers by.
+func extractEndpoint(endpoint interface{}) string {
+	switch end := endpoint.(type) {
+	case string:
+		return end
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,68 @@
+package telebot
+
+// topicHandlerKey identifies a handler registered for a specific
+// (chat, message thread) pair via HandleTopic.
+type topicHandlerKey struct {
+	chat     string
+	threadID int
+}
+
+// HandleTopic registers a handler that only fires for updates coming from
+// the given message thread (forum topic) inside chat, in addition to
+// whatever endpoint/chat/global handlers are registered via Handle.
+//
+// When an update arrives, the router first looks for a handler registered
+// for the exact (chat, thread) pair, then falls back to the regular
+// Handle-registered handler for endpoint. This lets a bot bridge a single
+// forum supergroup's topics to distinct handlers without manually
+// inspecting the incoming thread ID in every callback.
+func (b *Bot) HandleTopic(chat Recipient, threadID int, endpoint interface{}, h HandlerFunc, m ...MiddlewareFunc) {
+	end := extractEndpoint(endpoint)
+	if end == "" {
+		panic("telebot: unsupported endpoint")
+	}
+
+	handler := h
+	for i := len(m) - 1; i >= 0; i-- {
+		handler = m[i](handler)
+	}
+
+	if b.topicHandlers == nil {
+		b.topicHandlers = make(map[topicHandlerKey]map[string]HandlerFunc)
+	}
+
+	key := topicHandlerKey{chat: chat.Recipient(), threadID: threadID}
+	if b.topicHandlers[key] == nil {
+		b.topicHandlers[key] = make(map[string]HandlerFunc)
+	}
+	b.topicHandlers[key][end] = handler
+}
+
+// handleTopic dispatches end to the handler registered via HandleTopic for
+// the incoming update's (chat, thread) pair, falling back to the regular
+// Handle-registered handler when no topic-specific one is found.
+func (b *Bot) handleTopic(end string, c Context) bool {
+	m := c.Message()
+	if m == nil || m.Chat == nil {
+		return b.handle(end, c)
+	}
+
+	key := topicHandlerKey{chat: m.Chat.Recipient(), threadID: c.ThreadID()}
+	if handlers, ok := b.topicHandlers[key]; ok {
+		if h, ok := handlers[end]; ok {
+			b.runHandler(h, c)
+			return true
+		}
+	}
+
+	return b.handle(end, c)
+}
+
+// ThreadID returns the message thread (forum topic) ID the update this
+// context wraps originated from, or 0 if it isn't part of a topic.
+func (c *nativeContext) ThreadID() int {
+	if m := c.Message(); m != nil {
+		return m.ThreadID
+	}
+	return 0
+}
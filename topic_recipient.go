@@ -0,0 +1,16 @@
+package telebot
+
+// Topic points Bot.Send at a specific message thread (forum topic)
+// inside Chat, without having to set SendOptions.ThreadID by hand.
+//
+//	b.Send(&Topic{chat, 42}, "hi")
+type Topic struct {
+	Chat     *Chat
+	ThreadID int
+}
+
+// Recipient returns the chat ID the topic lives in, so a *Topic can be
+// passed anywhere a Recipient is expected.
+func (t *Topic) Recipient() string {
+	return t.Chat.Recipient()
+}
@@ -0,0 +1,70 @@
+package telebot
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendToTopicSetsMessageThreadID(t *testing.T) {
+	var gotThreadID string
+	var hasThreadID bool
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		_, hasThreadID = r.Form["message_thread_id"]
+		gotThreadID = r.FormValue("message_thread_id")
+		w.Write([]byte(`{"ok":true,"result":{"message_id":1,"chat":{"id":1}}}`))
+	}))
+	defer srv.Close()
+
+	b := &Bot{Token: "TEST", URL: srv.URL, client: http.DefaultClient}
+	chat := &Chat{ID: 1}
+
+	_, err := b.Send(&Topic{chat, 42}, "hi")
+	require.NoError(t, err)
+
+	require.True(t, hasThreadID, "message_thread_id should be present")
+	assert.Equal(t, "42", gotThreadID)
+}
+
+func TestSendToTopicDoesNotOverrideExplicitThreadID(t *testing.T) {
+	var gotThreadID string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		gotThreadID = r.FormValue("message_thread_id")
+		w.Write([]byte(`{"ok":true,"result":{"message_id":1,"chat":{"id":1}}}`))
+	}))
+	defer srv.Close()
+
+	b := &Bot{Token: "TEST", URL: srv.URL, client: http.DefaultClient}
+	chat := &Chat{ID: 1}
+
+	_, err := b.Send(&Topic{chat, 42}, "hi", &SendOptions{ThreadID: 7})
+	require.NoError(t, err)
+
+	assert.Equal(t, "7", gotThreadID)
+}
+
+func TestSendToTopicDoesNotMutateSharedOptions(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"ok":true,"result":{"message_id":1,"chat":{"id":1}}}`))
+	}))
+	defer srv.Close()
+
+	b := &Bot{Token: "TEST", URL: srv.URL, client: http.DefaultClient}
+	chat := &Chat{ID: 1}
+	shared := &SendOptions{}
+
+	_, err := b.Send(&Topic{chat, 42}, "hi", shared)
+	require.NoError(t, err)
+	assert.Equal(t, 0, shared.ThreadID, "Bot.Send must not mutate the caller's SendOptions")
+
+	_, err = b.Send(&Topic{chat, 99}, "hi", shared)
+	require.NoError(t, err)
+	assert.Equal(t, 0, shared.ThreadID)
+}
@@ -0,0 +1,47 @@
+package telebot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleTopicFallsBackToHandle(t *testing.T) {
+	chat := &Chat{ID: 1}
+	endpoint := "/start"
+
+	t.Run("uses topic handler when registered", func(t *testing.T) {
+		b := &Bot{}
+		var which string
+
+		b.Handle(endpoint, func(c Context) error { which = "global"; return nil })
+		b.HandleTopic(chat, 42, endpoint, func(c Context) error { which = "topic"; return nil })
+
+		c := &nativeContext{b: b, u: Update{Message: &Message{Chat: chat, ThreadID: 42}}}
+		ok := b.handleTopic(endpoint, c)
+
+		assert.True(t, ok)
+		assert.Equal(t, "topic", which)
+	})
+
+	t.Run("falls back to the endpoint handler outside the topic", func(t *testing.T) {
+		b := &Bot{}
+		var which string
+
+		b.Handle(endpoint, func(c Context) error { which = "global"; return nil })
+		b.HandleTopic(chat, 42, endpoint, func(c Context) error { which = "topic"; return nil })
+
+		c := &nativeContext{b: b, u: Update{Message: &Message{Chat: chat, ThreadID: 7}}}
+		ok := b.handleTopic(endpoint, c)
+
+		assert.True(t, ok)
+		assert.Equal(t, "global", which)
+	})
+
+	t.Run("reports false when nothing matches", func(t *testing.T) {
+		b := &Bot{}
+		c := &nativeContext{b: b, u: Update{Message: &Message{Chat: chat, ThreadID: 1}}}
+
+		assert.False(t, b.handleTopic(endpoint, c))
+	})
+}
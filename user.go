@@ -0,0 +1,17 @@
+package telebot
+
+import "strconv"
+
+// User represents a Telegram user or bot.
+type User struct {
+	ID        int64  `json:"id"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name,omitempty"`
+	Username  string `json:"username,omitempty"`
+	IsBot     bool   `json:"is_bot,omitempty"`
+}
+
+// Recipient returns user ID, implementing the Recipient interface.
+func (u *User) Recipient() string {
+	return strconv.FormatInt(u.ID, 10)
+}